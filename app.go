@@ -1,8 +1,10 @@
 package summer
 
 import (
+	"context"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"log"
 	"net/http"
 	"net/http/pprof"
 	"strings"
@@ -24,6 +26,19 @@ type App[T Context] interface {
 	//
 	// This function is similar with [http.ServeMux.HandleFunc]
 	HandleFunc(pattern string, fn HandlerFunc[T])
+
+	// Use registers app-wide [Decorator] wrapping every request that is not served by the built-in
+	// readiness, liveness, metrics or pprof routes, after tracing but before route dispatch
+	Use(decorators ...Decorator)
+
+	// Group returns a [RoutableApp] that registers handlers under prefix, wrapped with the given
+	// [HandlerDecorator]; the result does not support [App.Use] — pass group-scoped middleware
+	// here instead
+	Group(prefix string, decorators ...HandlerDecorator[T]) RoutableApp[T]
+
+	// Run starts the server, blocking until ctx is cancelled and the server has gracefully shut
+	// down, bounded by [WithShutdownTimeout]
+	Run(ctx context.Context) error
 }
 
 type app[T Context] struct {
@@ -33,9 +48,12 @@ type app[T Context] struct {
 	cf   ContextFactory[T]
 	opts options
 
-	mux *http.ServeMux
+	mux        *http.ServeMux
+	decorators []Decorator
 
+	root  *http.ServeMux
 	hMain http.Handler
+	hUser http.Handler
 	hProm http.Handler
 	hProf http.Handler
 
@@ -61,63 +79,133 @@ func (a *app[T]) HandleFunc(pattern string, fn HandlerFunc[T]) {
 	)
 }
 
-func (a *app[T]) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
-	// alive, ready, metrics
-	if req.URL.Path == a.opts.readinessPath {
-		// readiness first, works when readinessPath == livenessPath
-		sb := &strings.Builder{}
-		var failed bool
-		a.Check(req.Context(), func(name string, err error) {
-			if sb.Len() > 0 {
-				sb.WriteString("\n")
-			}
-			sb.WriteString(name)
-			if err == nil {
-				sb.WriteString(": OK")
-			} else {
-				failed = true
-				sb.WriteString(": ")
-				sb.WriteString(err.Error())
-			}
-		})
-		if sb.Len() == 0 {
-			sb.WriteString("OK")
+func (a *app[T]) Use(decorators ...Decorator) {
+	a.decorators = append(a.decorators, decorators...)
+	a.rebuildMain()
+}
+
+func (a *app[T]) Group(prefix string, decorators ...HandlerDecorator[T]) RoutableApp[T] {
+	return &group[T]{
+		parent:     a,
+		prefix:     prefix,
+		decorators: decorators,
+	}
+}
+
+// rebuildMain rebuilds the decorated user-route handler and the admin mux (readiness, liveness,
+// metrics, pprof) into a single [otelhttp]-wrapped handler, so that every branch — including
+// health, metrics and pprof — is traced with a stable route tag, not just user routes
+func (a *app[T]) rebuildMain() {
+	var h http.Handler = a.mux
+	for i := len(a.decorators) - 1; i >= 0; i-- {
+		h = a.decorators[i](h)
+	}
+	inner := h
+	a.hUser = http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if a.cc != nil {
+			<-a.cc
+			defer func() {
+				a.cc <- struct{}{}
+			}()
 		}
-		status := http.StatusOK
-		if failed {
-			atomic.AddInt64(&a.readinessFailed, 1)
-			status = http.StatusInternalServerError
-		} else {
-			atomic.StoreInt64(&a.readinessFailed, 0)
+		inner.ServeHTTP(rw, req)
+	})
+
+	a.root = &http.ServeMux{}
+	registered := map[string]bool{}
+	register := func(pattern, routeTag string, h http.HandlerFunc) {
+		// a user-configured path (e.g. WithReadinessPath) may collide with one of the fixed
+		// etcd-style paths; http.ServeMux.Handle panics on a duplicate pattern, so the
+		// already-registered handler wins and the later, colliding one is skipped
+		if registered[pattern] {
+			log.Printf("summer: %q is already registered for the admin mux, ignoring the %s route that collides with it", pattern, routeTag)
+			return
 		}
-		respondInternal(rw, sb.String(), status)
-		return
-	} else if req.URL.Path == a.opts.livenessPath {
-		if a.opts.readinessCascade > 0 && atomic.LoadInt64(&a.readinessFailed) > a.opts.readinessCascade {
-			respondInternal(rw, "CASCADED", http.StatusInternalServerError)
+		registered[pattern] = true
+		a.root.Handle(pattern, otelhttp.WithRouteTag(routeTag, h))
+	}
+
+	register(pathReadyz, "readiness", a.serveReadyz)
+	register(pathReadyz+"/", "readiness", a.serveReadyz)
+	register(pathLivez, "liveness", a.serveLivez)
+	register(pathLivez+"/", "liveness", a.serveLivez)
+	register(a.opts.readinessPath, "readiness", a.serveReadiness)
+	register(a.opts.livenessPath, "liveness", a.serveLiveness)
+	register(a.opts.metricsPath, "metrics", a.serveMetrics)
+	register("/debug/", "pprof", a.servePprof)
+	a.root.Handle("/", a.hUser)
+
+	a.hMain = otelhttp.NewHandler(a.root, "http")
+}
+
+func (a *app[T]) serveReadyz(rw http.ResponseWriter, req *http.Request) {
+	a.handleHealthz("readiness", pathReadyz, rw, req)
+}
+
+func (a *app[T]) serveLivez(rw http.ResponseWriter, req *http.Request) {
+	a.handleHealthz("liveness", pathLivez, rw, req)
+}
+
+func (a *app[T]) serveReadiness(rw http.ResponseWriter, req *http.Request) {
+	// readiness first, works when readinessPath == livenessPath
+	sb := &strings.Builder{}
+	var failed bool
+	a.Check(req.Context(), func(name string, err error) {
+		observeCheck("readiness", name, err)
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(name)
+		if err == nil {
+			sb.WriteString(": OK")
 		} else {
-			respondInternal(rw, "OK", http.StatusOK)
+			failed = true
+			sb.WriteString(": ")
+			sb.WriteString(err.Error())
 		}
-		return
-	} else if req.URL.Path == a.opts.metricsPath {
-		a.hProm.ServeHTTP(rw, req)
-		return
+	})
+	if sb.Len() == 0 {
+		sb.WriteString("OK")
+	}
+	status := http.StatusOK
+	if failed {
+		atomic.AddInt64(&a.readinessFailed, 1)
+		status = http.StatusInternalServerError
+	} else {
+		atomic.StoreInt64(&a.readinessFailed, 0)
 	}
+	respondInternal(rw, sb.String(), status)
+}
 
-	// pprof
-	if strings.HasPrefix(req.URL.Path, "/debug/") {
-		a.hProf.ServeHTTP(rw, req)
+func (a *app[T]) serveLiveness(rw http.ResponseWriter, _ *http.Request) {
+	if a.opts.readinessCascade > 0 && atomic.LoadInt64(&a.readinessFailed) > a.opts.readinessCascade {
+		respondInternal(rw, "CASCADED", http.StatusInternalServerError)
+	} else {
+		respondInternal(rw, "OK", http.StatusOK)
+	}
+}
+
+func (a *app[T]) serveMetrics(rw http.ResponseWriter, req *http.Request) {
+	if a.opts.metricsAuth != nil && !a.opts.metricsAuth(req) {
+		respondInternal(rw, "unauthorized", http.StatusUnauthorized)
 		return
 	}
+	a.hProm.ServeHTTP(rw, req)
+}
 
-	// concurrency control
-	if a.cc != nil {
-		<-a.cc
-		defer func() {
-			a.cc <- struct{}{}
-		}()
+func (a *app[T]) servePprof(rw http.ResponseWriter, req *http.Request) {
+	if !a.opts.profilingEnabled {
+		http.NotFound(rw, req)
+		return
 	}
+	if a.opts.profilingAuth != nil && !a.opts.profilingAuth(req) {
+		respondInternal(rw, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	a.hProf.ServeHTTP(rw, req)
+}
 
+func (a *app[T]) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	a.hMain.ServeHTTP(rw, req)
 }
 
@@ -131,6 +219,10 @@ func New[T Context](cf ContextFactory[T], opts ...Option) App[T] {
 			readinessPath:    DefaultReadinessPath,
 			livenessPath:     DefaultLivenessPath,
 			metricsPath:      DefaultMetricsPath,
+			addr:             DefaultAddr,
+			http2:            true,
+			shutdownTimeout:  DefaultShutdownTimeout,
+			profilingEnabled: true,
 		},
 	}
 
@@ -144,7 +236,7 @@ func New[T Context](cf ContextFactory[T], opts ...Option) App[T] {
 
 	a.mux = &http.ServeMux{}
 
-	a.hMain = otelhttp.NewHandler(a.mux, "http")
+	a.rebuildMain()
 	a.hProm = promhttp.Handler()
 	m := &http.ServeMux{}
 	m.HandleFunc("/debug/pprof/", pprof.Index)
@@ -0,0 +1,32 @@
+package summer
+
+import (
+	"crypto/subtle"
+	"net/http"
+)
+
+// BasicAuth returns a request predicate for [WithMetricsAuth] / [WithProfilingAuth] that requires
+// HTTP basic auth matching user and pass
+func BasicAuth(user, pass string) func(req *http.Request) bool {
+	return func(req *http.Request) bool {
+		u, p, ok := req.BasicAuth()
+		if !ok {
+			return false
+		}
+		return subtle.ConstantTimeCompare([]byte(u), []byte(user)) == 1 &&
+			subtle.ConstantTimeCompare([]byte(p), []byte(pass)) == 1
+	}
+}
+
+// BearerToken returns a request predicate for [WithMetricsAuth] / [WithProfilingAuth] that requires
+// an `Authorization: Bearer <tok>` header matching tok
+func BearerToken(tok string) func(req *http.Request) bool {
+	const prefix = "Bearer "
+	return func(req *http.Request) bool {
+		h := req.Header.Get("Authorization")
+		if len(h) != len(prefix)+len(tok) || h[:len(prefix)] != prefix {
+			return false
+		}
+		return subtle.ConstantTimeCompare([]byte(h[len(prefix):]), []byte(tok)) == 1
+	}
+}
@@ -0,0 +1,73 @@
+package summer
+
+import (
+	"net"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	connAccepted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "summer_conn_accepted_total",
+			Help: "total number of accepted connections, by listener",
+		},
+		[]string{"listener"},
+	)
+	connClosed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "summer_conn_closed_total",
+			Help: "total number of closed connections, by listener",
+		},
+		[]string{"listener"},
+	)
+	connInFlight = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "summer_conn_in_flight",
+			Help: "current number of open connections, by listener",
+		},
+		[]string{"listener"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(connAccepted, connClosed, connInFlight)
+}
+
+// conntrackListener wraps a [net.Listener], exposing accepted, closed and in-flight connection
+// counts per listener name, in the style of mwitkow/go-conntrack
+type conntrackListener struct {
+	net.Listener
+	name string
+}
+
+func newConntrackListener(name string, l net.Listener) net.Listener {
+	return &conntrackListener{Listener: l, name: name}
+}
+
+func (l *conntrackListener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	connAccepted.WithLabelValues(l.name).Inc()
+	connInFlight.WithLabelValues(l.name).Inc()
+	return &conntrackConn{Conn: c, name: l.name}, nil
+}
+
+type conntrackConn struct {
+	net.Conn
+	name      string
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func (c *conntrackConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.closeErr = c.Conn.Close()
+		connClosed.WithLabelValues(c.name).Inc()
+		connInFlight.WithLabelValues(c.name).Dec()
+	})
+	return c.closeErr
+}
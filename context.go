@@ -0,0 +1,24 @@
+package summer
+
+import "net/http"
+
+// Context is the interface implemented by per-request contexts passed to [HandlerFunc]
+type Context interface {
+	// Perform is invoked after the [HandlerFunc] returns, for finalization such as flushing logs or metrics
+	Perform()
+}
+
+// ContextFactory creates a [T] for the given request
+type ContextFactory[T Context] func(rw http.ResponseWriter, req *http.Request) T
+
+type basicContext struct {
+	Rw  http.ResponseWriter
+	Req *http.Request
+}
+
+func (c *basicContext) Perform() {}
+
+// BasicContext is the [ContextFactory] used by [Basic]
+func BasicContext(rw http.ResponseWriter, req *http.Request) Context {
+	return &basicContext{Rw: rw, Req: req}
+}
@@ -0,0 +1,10 @@
+package summer
+
+import "net/http"
+
+// Decorator wraps an [http.Handler] to add cross-cutting behavior such as logging, auth or rate
+// limiting; registered app-wide via [App.Use]
+type Decorator func(http.Handler) http.Handler
+
+// HandlerDecorator wraps a [HandlerFunc] with [T] context; registered per-group via [App.Group]
+type HandlerDecorator[T Context] func(next HandlerFunc[T]) HandlerFunc[T]
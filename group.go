@@ -0,0 +1,35 @@
+package summer
+
+// RoutableApp is the subset of [App] returned by [App.Group]: it can register handlers and nest
+// further groups, but deliberately does not expose [App.Use] — an app-wide decorator stack has no
+// well-defined per-group scope, so a group's middleware must be passed to [App.Group] itself
+type RoutableApp[T Context] interface {
+	// HandleFunc register an action function with given path pattern, under the group's prefix
+	HandleFunc(pattern string, fn HandlerFunc[T])
+
+	// Group returns a further-nested [RoutableApp] under prefix
+	Group(prefix string, decorators ...HandlerDecorator[T]) RoutableApp[T]
+}
+
+// group is the [RoutableApp] returned by [App.Group]: it prefixes every pattern registered through
+// it and applies its own [HandlerDecorator] stack, delegating registration to its parent
+type group[T Context] struct {
+	parent     RoutableApp[T]
+	prefix     string
+	decorators []HandlerDecorator[T]
+}
+
+func (g *group[T]) HandleFunc(pattern string, fn HandlerFunc[T]) {
+	for i := len(g.decorators) - 1; i >= 0; i-- {
+		fn = g.decorators[i](fn)
+	}
+	g.parent.HandleFunc(g.prefix+pattern, fn)
+}
+
+func (g *group[T]) Group(prefix string, decorators ...HandlerDecorator[T]) RoutableApp[T] {
+	return &group[T]{
+		parent:     g,
+		prefix:     prefix,
+		decorators: decorators,
+	}
+}
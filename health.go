@@ -0,0 +1,115 @@
+package summer
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	pathReadyz = "/readyz"
+	pathLivez  = "/livez"
+)
+
+var (
+	checkResult = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "summer_check_result",
+			Help: "last outcome of a registered check, 1 for pass, 0 for fail",
+		},
+		[]string{"type", "name"},
+	)
+	checkTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "summer_check_total",
+			Help: "total number of evaluations of a registered check",
+		},
+		[]string{"type", "name", "status"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(checkResult, checkTotal)
+}
+
+func observeCheck(typ, name string, err error) {
+	if err == nil {
+		checkResult.WithLabelValues(typ, name).Set(1)
+		checkTotal.WithLabelValues(typ, name, "pass").Inc()
+	} else {
+		checkResult.WithLabelValues(typ, name).Set(0)
+		checkTotal.WithLabelValues(typ, name, "fail").Inc()
+	}
+}
+
+// handleHealthz implements the etcd-style /readyz and /livez probes: a bare request runs every
+// registered check, ?verbose returns a per-check listing, repeated ?exclude=<name> leaves named
+// checks out of the result and the metrics (they are still run), and a /<name> subpath runs
+// exactly that one check
+func (a *app[T]) handleHealthz(typ, prefix string, rw http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+
+	if name := strings.TrimPrefix(req.URL.Path, prefix+"/"); name != req.URL.Path && name != "" {
+		err, found := a.CheckOne(ctx, name)
+		if !found {
+			respondInternal(rw, "unknown check: "+name, http.StatusNotFound)
+			return
+		}
+		observeCheck(typ, name, err)
+		if err != nil {
+			respondInternal(rw, name+": "+err.Error(), http.StatusInternalServerError)
+		} else {
+			respondInternal(rw, name+": OK", http.StatusOK)
+		}
+		return
+	}
+
+	excluded := map[string]bool{}
+	for _, name := range req.URL.Query()["exclude"] {
+		excluded[name] = true
+	}
+	_, verbose := req.URL.Query()["verbose"]
+
+	sb := &strings.Builder{}
+	var failed bool
+	a.Check(ctx, func(name string, err error) {
+		if excluded[name] {
+			return
+		}
+		observeCheck(typ, name, err)
+		if err != nil {
+			failed = true
+		}
+		if !verbose {
+			return
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		if err == nil {
+			sb.WriteString("[+]" + name)
+		} else {
+			sb.WriteString("[-]" + name + ": " + err.Error())
+		}
+	})
+
+	status := http.StatusOK
+	if failed {
+		status = http.StatusInternalServerError
+	}
+	if !verbose {
+		if failed {
+			respondInternal(rw, typ+" check failed", status)
+		} else {
+			respondInternal(rw, typ+" check passed", status)
+		}
+		return
+	}
+	if failed {
+		sb.WriteString("\n" + typ + " check failed")
+	} else {
+		sb.WriteString("\n" + typ + " check passed")
+	}
+	respondInternal(rw, sb.String(), status)
+}
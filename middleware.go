@@ -0,0 +1,82 @@
+package summer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Recovery is a [Decorator] that recovers from panics in downstream handlers, responding 500
+// instead of crashing the server
+func Recovery() Decorator {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			defer func() {
+				if r := recover(); r != nil {
+					log.Printf("panic: %v", r)
+					respondInternal(rw, "internal server error", http.StatusInternalServerError)
+				}
+			}()
+			next.ServeHTTP(rw, req)
+		})
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// AccessLog is a [Decorator] that logs the method, path, status and duration of every request
+func AccessLog() Decorator {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			start := time.Now()
+			sw := &statusWriter{ResponseWriter: rw, status: http.StatusOK}
+			next.ServeHTTP(sw, req)
+			log.Printf("%s %s %d %s", req.Method, req.URL.Path, sw.status, time.Since(start))
+		})
+	}
+}
+
+type requestIDKey struct{}
+
+// RequestIDHeader is the header used by [RequestID] to read and echo a request's ID
+const RequestIDHeader = "X-Request-Id"
+
+// RequestID is a [Decorator] that injects a request ID into the request context, generating one
+// if the incoming request did not already carry one in [RequestIDHeader], and echoes it back in
+// the response
+func RequestID() Decorator {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			id := req.Header.Get(RequestIDHeader)
+			if id == "" {
+				id = newRequestID()
+			}
+			rw.Header().Set(RequestIDHeader, id)
+			req = req.WithContext(context.WithValue(req.Context(), requestIDKey{}, id))
+			next.ServeHTTP(rw, req)
+		})
+	}
+}
+
+// RequestIDFromContext returns the request ID injected by [RequestID], or "" if absent
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
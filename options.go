@@ -0,0 +1,116 @@
+package summer
+
+import (
+	"crypto/x509"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultReadinessPath is the default path for the combined readiness probe
+	DefaultReadinessPath = "/-/ready"
+	// DefaultLivenessPath is the default path for the combined liveness probe
+	DefaultLivenessPath = "/-/alive"
+	// DefaultMetricsPath is the default path for the Prometheus metrics handler
+	DefaultMetricsPath = "/metrics"
+	// DefaultAddr is the default address [App.Run] listens on
+	DefaultAddr = ":8080"
+	// DefaultShutdownTimeout is the default duration [App.Run] waits for in-flight requests to drain
+	DefaultShutdownTimeout = 15 * time.Second
+)
+
+// Option configures an [App] created by [New] or [Basic]
+type Option func(opts *options)
+
+type options struct {
+	concurrency      int
+	readinessCascade int64
+	readinessPath    string
+	livenessPath     string
+	metricsPath      string
+
+	addr            string
+	tlsCertFile     string
+	tlsKeyFile      string
+	clientCAs       *x509.CertPool
+	http2           bool
+	shutdownTimeout time.Duration
+
+	metricsAuth      func(req *http.Request) bool
+	profilingAuth    func(req *http.Request) bool
+	profilingEnabled bool
+}
+
+// WithConcurrency sets the maximum number of concurrent requests served by [App.ServeHTTP], 0 disables the limit;
+// requests to the built-in readiness, liveness, metrics and pprof routes are not counted
+func WithConcurrency(n int) Option {
+	return func(opts *options) { opts.concurrency = n }
+}
+
+// WithReadinessCascade sets the number of consecutive failures of the readiness path that liveness tolerates: once more
+// than n have failed in a row, the liveness path also fails; 0 disables cascading
+func WithReadinessCascade(n int64) Option {
+	return func(opts *options) { opts.readinessCascade = n }
+}
+
+// WithReadinessPath overrides [DefaultReadinessPath]
+func WithReadinessPath(path string) Option {
+	return func(opts *options) { opts.readinessPath = path }
+}
+
+// WithLivenessPath overrides [DefaultLivenessPath]
+func WithLivenessPath(path string) Option {
+	return func(opts *options) { opts.livenessPath = path }
+}
+
+// WithMetricsPath overrides [DefaultMetricsPath]
+func WithMetricsPath(path string) Option {
+	return func(opts *options) { opts.metricsPath = path }
+}
+
+// WithAddr overrides [DefaultAddr], the address [App.Run] listens on
+func WithAddr(addr string) Option {
+	return func(opts *options) { opts.addr = addr }
+}
+
+// WithTLS enables TLS on [App.Run], loading the certificate and key from the given files
+func WithTLS(certFile, keyFile string) Option {
+	return func(opts *options) {
+		opts.tlsCertFile = certFile
+		opts.tlsKeyFile = keyFile
+	}
+}
+
+// WithClientCAs enables mutual TLS on [App.Run], requiring and verifying client certificates
+// against pool; only effective alongside [WithTLS]
+func WithClientCAs(pool *x509.CertPool) Option {
+	return func(opts *options) { opts.clientCAs = pool }
+}
+
+// WithHTTP2 controls whether [App.Run] allows HTTP/2 over TLS, true by default
+func WithHTTP2(enabled bool) Option {
+	return func(opts *options) { opts.http2 = enabled }
+}
+
+// WithShutdownTimeout overrides [DefaultShutdownTimeout], the duration [App.Run] waits for
+// in-flight requests to finish before forcibly closing remaining connections
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(opts *options) { opts.shutdownTimeout = d }
+}
+
+// WithMetricsAuth gates the metrics path behind fn, which must return true to allow the request
+func WithMetricsAuth(fn func(req *http.Request) bool) Option {
+	return func(opts *options) { opts.metricsAuth = fn }
+}
+
+// WithProfilingAuth gates the /debug/pprof paths behind fn, which must return true to allow the
+// request
+func WithProfilingAuth(fn func(req *http.Request) bool) Option {
+	return func(opts *options) { opts.profilingAuth = fn }
+}
+
+// WithProfilingEnabled controls whether /debug/pprof is served at all, true by default; disable it
+// to remove the profiling surface entirely in hardened builds
+func WithProfilingEnabled(enabled bool) Option {
+	return func(opts *options) { opts.profilingEnabled = enabled }
+}
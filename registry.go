@@ -0,0 +1,105 @@
+package summer
+
+import (
+	"context"
+	"reflect"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var checkTracer = otel.Tracer("summer")
+
+// CheckFunc is the function signature of a single named health check
+type CheckFunc func(ctx context.Context) error
+
+// Registry collects injectable components and named health checks shared by an [App]
+type Registry interface {
+	// Register adds components to the registry, to be injected into future [Context] values by [Registry.Inject]
+	Register(components ...interface{})
+
+	// Inject assigns registered components into the exported fields of obj that match by type
+	Inject(obj interface{})
+
+	// Checker registers a named health check
+	Checker(name string, fn CheckFunc)
+
+	// Check runs every registered check, invoking cb with the result of each, in registration order
+	Check(ctx context.Context, cb func(name string, err error))
+
+	// CheckOne runs a single named check and reports whether it was found
+	CheckOne(ctx context.Context, name string) (err error, found bool)
+}
+
+type namedCheck struct {
+	name string
+	fn   CheckFunc
+}
+
+type registry struct {
+	components []interface{}
+	checks     []namedCheck
+}
+
+// NewRegistry creates an empty [Registry]
+func NewRegistry() Registry {
+	return &registry{}
+}
+
+func (r *registry) Register(components ...interface{}) {
+	r.components = append(r.components, components...)
+}
+
+func (r *registry) Inject(obj interface{}) {
+	rv := reflect.ValueOf(obj)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+		for _, c := range r.components {
+			cv := reflect.ValueOf(c)
+			if cv.Type().AssignableTo(field.Type()) {
+				field.Set(cv)
+				break
+			}
+		}
+	}
+}
+
+func (r *registry) Checker(name string, fn CheckFunc) {
+	r.checks = append(r.checks, namedCheck{name: name, fn: fn})
+}
+
+func (r *registry) Check(ctx context.Context, cb func(name string, err error)) {
+	for _, c := range r.checks {
+		cb(c.name, runChecked(ctx, c.name, c.fn))
+	}
+}
+
+func (r *registry) CheckOne(ctx context.Context, name string) (error, bool) {
+	for _, c := range r.checks {
+		if c.name == name {
+			return runChecked(ctx, name, c.fn), true
+		}
+	}
+	return nil, false
+}
+
+// runChecked runs fn inside a child span named after the check, recording the error as a failed
+// span status
+func runChecked(ctx context.Context, name string, fn CheckFunc) error {
+	ctx, span := checkTracer.Start(ctx, name)
+	defer span.End()
+
+	err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,9 @@
+package summer
+
+import "net/http"
+
+func respondInternal(rw http.ResponseWriter, body string, status int) {
+	rw.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	rw.WriteHeader(status)
+	_, _ = rw.Write([]byte(body))
+}
@@ -0,0 +1,80 @@
+package summer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+)
+
+const conntrackListenerName = "summer"
+
+// Run implements [App.Run]
+func (a *app[T]) Run(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:    a.opts.addr,
+		Handler: a,
+	}
+
+	tlsEnabled := a.opts.tlsCertFile != ""
+	if tlsEnabled {
+		cert, err := tls.LoadX509KeyPair(a.opts.tlsCertFile, a.opts.tlsKeyFile)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+		if a.opts.clientCAs != nil {
+			srv.TLSConfig.ClientCAs = a.opts.clientCAs
+			srv.TLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		if a.opts.http2 {
+			// required for http.Server's shouldConfigureHTTP2ForServe to enable HTTP/2 over TLS
+			srv.TLSConfig.NextProtos = []string{"h2", "http/1.1"}
+		} else {
+			srv.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+		}
+	}
+
+	ln, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		return err
+	}
+	ln = newConntrackListener(conntrackListenerName, ln)
+
+	errCh := make(chan error, 1)
+	go func() {
+		if tlsEnabled {
+			// certificate is already loaded into srv.TLSConfig, so certFile/keyFile are empty
+			errCh <- srv.ServeTLS(ln, "", "")
+		} else {
+			errCh <- srv.Serve(ln)
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), a.opts.shutdownTimeout)
+		defer cancel()
+		err := srv.Shutdown(shutdownCtx)
+		if err != nil {
+			// the deadline elapsed with requests still in flight: close the remaining connections
+			_ = srv.Close()
+		}
+
+		// drain the concurrency semaphore, bounded by the same deadline as Shutdown: Go does not
+		// kill in-flight handlers when the shutdown deadline elapses, so a handler still running
+		// past the deadline would otherwise block this loop forever
+		if a.cc != nil {
+			for i := 0; i < a.opts.concurrency; i++ {
+				select {
+				case <-a.cc:
+				case <-shutdownCtx.Done():
+					return err
+				}
+			}
+		}
+		return err
+	}
+}